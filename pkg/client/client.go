@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client builds the Kubernetes and OpenEBS clientsets that every
+// openebsctl command is handed, scoped to a single namespace.
+package client
+
+import (
+	"fmt"
+
+	hwameistorclientset "github.com/hwameistor/hwameistor/pkg/apis/client/clientset/versioned"
+	openebsclientset "github.com/openebs/api/pkg/client/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// K8sClient bundles the clientsets the CLI talks to, scoped to Ns.
+type K8sClient struct {
+	// Ns is the namespace commands operate against, e.g. the OpenEBS
+	// control-plane namespace.
+	Ns string
+	// K8sCS is the core Kubernetes clientset, used for generic resources
+	// such as PersistentVolumeClaims.
+	K8sCS kubernetes.Interface
+	// OpenebsCS is the generated clientset for OpenEBS's own CRDs
+	// (CStorVolumeReplica, CStorPoolInstance, CStorPoolCluster,
+	// CStorVolumeConfig, ...).
+	OpenebsCS openebsclientset.Interface
+	// HwameistorCS is the generated clientset for Hwameistor's CRDs.
+	HwameistorCS hwameistorclientset.Interface
+}
+
+// NewK8sClient builds a K8sClient from the ambient kubeconfig (in-cluster
+// config when running as a pod, otherwise the local kubeconfig), scoped to
+// ns.
+func NewK8sClient(ns string) (*K8sClient, error) {
+	cfg, err := restConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kubernetes config: %w", err)
+	}
+	k8sCS, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kubernetes clientset: %w", err)
+	}
+	openebsCS, err := openebsclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openebs clientset: %w", err)
+	}
+	hwameistorCS, err := hwameistorclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build hwameistor clientset: %w", err)
+	}
+	return &K8sClient{
+		Ns:           ns,
+		K8sCS:        k8sCS,
+		OpenebsCS:    openebsCS,
+		HwameistorCS: hwameistorCS,
+	}, nil
+}
+
+// restConfig resolves the in-cluster config first, falling back to the
+// caller's local kubeconfig.
+func restConfig() (*rest.Config, error) {
+	if cfg, err := rest.InClusterConfig(); err == nil {
+		return cfg, nil
+	}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(),
+		&clientcmd.ConfigOverrides{},
+	).ClientConfig()
+}
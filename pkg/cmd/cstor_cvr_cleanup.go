@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openebs/openebsctl/pkg/client"
+	"github.com/openebs/openebsctl/pkg/cstor/cvr"
+	"github.com/openebs/openebsctl/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var cstorCVRCleanupHelp = `
+Usage: openebsctl cstor cvr cleanup [flags]
+
+Finds CVRs stuck in DeletionFailed, or whose deletion has been pending
+longer than --older-than, and strips their finalizer so the underlying
+namespace/operator deletion can proceed. Refuses to touch a CVR whose pool
+is present and Healthy.
+
+Example:
+  $ openebsctl cstor cvr cleanup --older-than 1h --dry-run
+  $ openebsctl cstor cvr cleanup --all-namespaces -o json
+`
+
+// NewCmdCstorCVRCleanup instantiates the cstor cvr cleanup command.
+func NewCmdCstorCVRCleanup() *cobra.Command {
+	var (
+		dryRun        bool
+		allNamespaces bool
+		olderThan     time.Duration
+		finalizer     string
+		output        string
+		yes           bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "cleanup",
+		Short:   "Strip stuck finalizers off orphaned CStorVolumeReplicas",
+		Long:    cstorCVRCleanupHelp,
+		Example: "openebsctl cstor cvr cleanup --older-than 1h --dry-run",
+		Run: func(cmd *cobra.Command, args []string) {
+			if output != "" && output != "json" {
+				util.CheckErr(fmt.Errorf("unsupported --output %q: only \"json\" is supported", output), util.Fatal)
+			}
+
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+
+			stuck, err := cvr.Find(c, openebsNamespace, allNamespaces, olderThan)
+			util.CheckErr(err, util.Fatal)
+
+			if output == "json" {
+				util.CheckErr(json.NewEncoder(os.Stdout).Encode(stuck), util.Fatal)
+				return
+			}
+
+			for _, s := range stuck {
+				safe, err := cvr.PoolIsSafeToCleanup(c, s.CVR)
+				util.CheckErr(err, util.Fatal)
+				if !safe {
+					fmt.Printf("skipping %s/%s: pool is present and Healthy (%s)\n", s.CVR.Namespace, s.CVR.Name, s.Reason)
+					continue
+				}
+				if !dryRun && !yes && !confirm(s.CVR.Namespace, s.CVR.Name, s.Reason) {
+					fmt.Printf("skipped %s/%s\n", s.CVR.Namespace, s.CVR.Name)
+					continue
+				}
+				util.CheckErr(cvr.StripFinalizer(c, s.CVR, finalizer, dryRun), util.Fatal)
+				verb := "stripped"
+				if dryRun {
+					verb = "would strip"
+				}
+				fmt.Printf("%s finalizer on %s/%s (%s)\n", verb, s.CVR.Namespace, s.CVR.Name, s.Reason)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report what would be cleaned up without making changes")
+	cmd.Flags().BoolVar(&allNamespaces, "all-namespaces", false, "consider CVRs across all namespaces")
+	cmd.Flags().DurationVar(&olderThan, "older-than", time.Hour, "treat a pending deletion older than this as stuck")
+	cmd.Flags().StringVar(&finalizer, "finalizer", "", "finalizer to strip (defaults to the built-in CVR finalizer)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "print the candidate list as this format instead of acting on it (supported: json)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "skip the interactive confirmation prompt")
+	return cmd
+}
+
+// confirm asks the operator to approve stripping the finalizer of a single
+// CVR, since this is a destructive, hard-to-reverse action.
+func confirm(ns, name, reason string) bool {
+	fmt.Printf("strip finalizer on %s/%s (%s)? [y/N]: ", ns, name, reason)
+	reader := bufio.NewReader(os.Stdin)
+	answer, _ := reader.ReadString('\n')
+	return strings.EqualFold(strings.TrimSpace(answer), "y")
+}
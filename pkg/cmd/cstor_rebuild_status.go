@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openebs/openebsctl/pkg/client"
+	"github.com/openebs/openebsctl/pkg/cstor/rebuild"
+	"github.com/openebs/openebsctl/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var cstorRebuildStatusHelp = `
+Usage: openebsctl cstor rebuild-status [cvr] [flags]
+
+Reports rebuild progress and an ETA for a CVR that is Rebuilding,
+ReconstructingNewReplica or NewReplicaDegraded, by sampling Status.Capacity.Used
+at --interval and comparing it against a healthy peer's logical-referenced
+size.
+
+Example:
+  $ openebsctl cstor rebuild-status pvc-1234-cspi-2 --watch
+`
+
+// NewCmdCstorRebuildStatus instantiates the cstor rebuild-status command.
+func NewCmdCstorRebuildStatus() *cobra.Command {
+	var (
+		volName  string
+		interval time.Duration
+		watch    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "rebuild-status [cvr]",
+		Short:   "Show rebuild progress and ETA for a rebuilding CStorVolumeReplica",
+		Long:    cstorRebuildStatusHelp,
+		Example: "openebsctl cstor rebuild-status pvc-1234-cspi-2 --watch",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+
+			tracker := rebuild.NewTracker()
+			first := true
+			for {
+				peer, err := rebuild.FindHealthyPeer(c, volName, args[0])
+				util.CheckErr(err, util.Fatal)
+				est, err := tracker.Poll(c, args[0], peer)
+				util.CheckErr(err, util.Fatal)
+				printEstimate(os.Stdout, est, watch && !first)
+				first = false
+				if !watch || est.Complete {
+					return
+				}
+				time.Sleep(interval)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&volName, "volume", "", "name of the volume the CVR belongs to (required)")
+	cmd.Flags().DurationVar(&interval, "interval", 5*time.Second, "sampling interval used in --watch mode")
+	cmd.Flags().BoolVar(&watch, "watch", false, "keep sampling and redraw the estimate in place")
+	cmd.MarkFlagRequired("volume")
+	return cmd
+}
+
+// rebuildStatusRows is the number of lines printEstimate emits (header +
+// one data row), used to move the cursor back up for a --watch redraw.
+const rebuildStatusRows = 2
+
+// printEstimate renders est as a table to out. When redraw is true (every
+// --watch tick but the first), it first moves the cursor up over the
+// previous render and clears to the end of the screen, so each tick
+// redraws the same two lines in place instead of scrolling a new table.
+func printEstimate(out io.Writer, est *rebuild.Estimate, redraw bool) {
+	if redraw {
+		fmt.Fprintf(out, "\033[%dA\033[J", rebuildStatusRows)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "CVR\tPHASE\tUSED\tTARGET\tTHROUGHPUT\tETA")
+	switch {
+	case est.Complete:
+		fmt.Fprintf(w, "%s\t%s\t-\t-\t-\tdone\n", est.CVRName, est.Phase)
+	case est.Stalled:
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t-\tstalled\n", est.CVRName, est.Phase, est.Used, est.PeerReferenced)
+	default:
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%.0f B/s\t%s\n", est.CVRName, est.Phase, est.Used, est.PeerReferenced, est.ThroughputBps, est.ETA.Round(time.Second))
+	}
+	w.Flush()
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/openebs/openebsctl/pkg/client"
+	"github.com/openebs/openebsctl/pkg/cstor/scale"
+	"github.com/openebs/openebsctl/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cstorScaleCmdHelp = `
+Usage: openebsctl cstor scale [volume] [flags]
+
+Scales the number of cStor volume replicas for a given volume up or down,
+provisioning or removing CStorVolumeReplica resources as needed.
+
+Example:
+  $ openebsctl cstor scale pvc-1234 --replicas 3 --cspi cspi-1,cspi-2,cspi-3
+`
+)
+
+// NewCmdCstorScale instantiates the cstor scale command which grows or
+// shrinks the replica count of a cStor volume.
+func NewCmdCstorScale() *cobra.Command {
+	var replicas int
+	var cspiNames []string
+
+	cmd := &cobra.Command{
+		Use:     "scale [volume]",
+		Short:   "Scale the replica count of a cStor volume up or down",
+		Long:    cstorScaleCmdHelp,
+		Example: "openebsctl cstor scale pvc-1234 --replicas 3 --cspi cspi-1,cspi-2,cspi-3",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(scale.Scale(c, args[0], replicas, cspiNames), util.Fatal)
+		},
+	}
+	cmd.Flags().IntVar(&replicas, "replicas", 0, "desired number of replicas for the volume")
+	cmd.Flags().StringSliceVar(&cspiNames, "cspi", nil, "CSPI names to place newly added replicas on")
+	return cmd
+}
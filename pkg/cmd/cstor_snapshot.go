@@ -0,0 +1,150 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openebs/openebsctl/pkg/client"
+	"github.com/openebs/openebsctl/pkg/cstor/snapshot"
+	"github.com/openebs/openebsctl/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdCstorSnapshot instantiates the cstor snapshot command group with
+// its list, describe, create, delete and clone subcommands.
+func NewCmdCstorSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage snapshots and clones of cStor volumes",
+	}
+	cmd.AddCommand(
+		newCmdCstorSnapshotList(),
+		newCmdCstorSnapshotDescribe(),
+		newCmdCstorSnapshotCreate(),
+		newCmdCstorSnapshotDelete(),
+		newCmdCstorSnapshotClone(),
+	)
+	return cmd
+}
+
+func newCmdCstorSnapshotList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [volume]",
+		Short:   "List snapshots of a cStor volume, flagging any still pending on a replica",
+		Args:    cobra.ExactArgs(1),
+		Example: "openebsctl cstor snapshot list pvc-1234",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			infos, err := snapshot.List(c, args[0])
+			util.CheckErr(err, util.Fatal)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NAME\tREPLICAS\tPENDING ON")
+			for _, info := range infos {
+				fmt.Fprintf(w, "%s\t%d\t%v\n", info.Name, len(info.LogicalReferenced), info.PendingOn)
+			}
+			w.Flush()
+		},
+	}
+}
+
+func newCmdCstorSnapshotDescribe() *cobra.Command {
+	return &cobra.Command{
+		Use:     "describe [volume] [snapshot]",
+		Short:   "Show per-replica logical-referenced size for a snapshot, highlighting divergence",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl cstor snapshot describe pvc-1234 snap-1",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			info, err := snapshot.Describe(c, args[0], args[1])
+			util.CheckErr(err, util.Fatal)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "REPLICA\tLOGICAL REFERENCED")
+			for replica, size := range info.LogicalReferenced {
+				fmt.Fprintf(w, "%s\t%d\n", replica, size)
+			}
+			w.Flush()
+			var lo, hi uint64
+			first := true
+			for _, size := range info.LogicalReferenced {
+				if first || size < lo {
+					lo = size
+				}
+				if first || size > hi {
+					hi = size
+				}
+				first = false
+			}
+			if lo != hi {
+				fmt.Printf("diverged: logical-referenced size differs across replicas (min %d, max %d)\n", lo, hi)
+			}
+			if len(info.PendingOn) > 0 {
+				fmt.Printf("pending on: %v\n", info.PendingOn)
+			}
+		},
+	}
+}
+
+func newCmdCstorSnapshotCreate() *cobra.Command {
+	return &cobra.Command{
+		Use:     "create [volume] [snapshot]",
+		Short:   "Create a new snapshot of a cStor volume",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl cstor snapshot create pvc-1234 snap-1",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(snapshot.Create(c, args[0], args[1]), util.Fatal)
+		},
+	}
+}
+
+func newCmdCstorSnapshotDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete [volume] [snapshot]",
+		Short:   "Delete a snapshot of a cStor volume",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl cstor snapshot delete pvc-1234 snap-1",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(snapshot.Delete(c, args[0], args[1]), util.Fatal)
+		},
+	}
+}
+
+func newCmdCstorSnapshotClone() *cobra.Command {
+	return &cobra.Command{
+		Use:     "clone [volume] [snapshot] [new-pvc-name]",
+		Short:   "Provision a new PVC cloned from a cStor volume snapshot",
+		Args:    cobra.ExactArgs(3),
+		Example: "openebsctl cstor snapshot clone pvc-1234 snap-1 pvc-clone",
+		Run: func(cmd *cobra.Command, args []string) {
+			c, err := client.NewK8sClient(openebsNamespace)
+			util.CheckErr(err, util.Fatal)
+			pvc, err := snapshot.Clone(c, args[0], args[1], args[2])
+			util.CheckErr(err, util.Fatal)
+			fmt.Printf("created %s\n", pvc.Name)
+		},
+	}
+}
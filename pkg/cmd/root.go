@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmd wires up the openebsctl cobra command tree.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// openebsNamespace is the namespace every command talks to, set via the
+// --openebs-namespace persistent flag on the root command.
+var openebsNamespace string
+
+// NewCmdRoot instantiates the root openebsctl command and attaches every
+// engine/resource subcommand group.
+func NewCmdRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openebsctl",
+		Short: "A kubectl plugin for troubleshooting OpenEBS storage volumes and pools",
+	}
+	cmd.PersistentFlags().StringVarP(&openebsNamespace, "openebs-namespace", "n", "openebs", "namespace the OpenEBS control plane runs in")
+
+	cstor := &cobra.Command{
+		Use:   "cstor",
+		Short: "Manage cStor volumes, pools and replicas",
+	}
+	cstor.AddCommand(NewCmdCstorScale())
+	cstor.AddCommand(NewCmdCstorSnapshot())
+	cstor.AddCommand(NewCmdCstorRebuildStatus())
+
+	cvr := &cobra.Command{
+		Use:   "cvr",
+		Short: "Manage CStorVolumeReplica resources directly",
+	}
+	cvr.AddCommand(NewCmdCstorCVRCleanup())
+	cstor.AddCommand(cvr)
+
+	volume := &cobra.Command{
+		Use:   "volume",
+		Short: "Manage volumes across every installed OpenEBS engine",
+	}
+	volume.AddCommand(NewCmdVolumeReplicate())
+
+	cmd.AddCommand(cstor, volume)
+	return cmd
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openebs/openebsctl/pkg/client"
+	"github.com/openebs/openebsctl/pkg/util"
+	"github.com/openebs/openebsctl/pkg/volume/replicate"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdVolumeReplicate instantiates the top-level volume replicate
+// command, which dispatches List/Promote/Demote/Resync/Status operations
+// to the replicate.Backend registered for the volume's engine.
+func NewCmdVolumeReplicate() *cobra.Command {
+	var engine string
+
+	cmd := &cobra.Command{
+		Use:   "replicate",
+		Short: "Manage volume replication across cStor, ZFS-LocalPV and Hwameistor",
+	}
+	cmd.PersistentFlags().StringVar(&engine, "engine", "cstor", "engine the volume belongs to (cstor, zfs-localpv, hwameistor)")
+
+	cmd.AddCommand(
+		newCmdVolumeReplicateList(&engine),
+		newCmdVolumeReplicatePromote(&engine),
+		newCmdVolumeReplicateDemote(&engine),
+		newCmdVolumeReplicateResync(&engine),
+		newCmdVolumeReplicateStatus(&engine),
+	)
+	return cmd
+}
+
+// registryFor builds a Registry with every known backend wired against the
+// current cluster and returns the Backend matching engine.
+func registryFor(engine string) (replicate.Backend, error) {
+	c, err := client.NewK8sClient(openebsNamespace)
+	if err != nil {
+		return nil, err
+	}
+	registry := replicate.NewRegistry(
+		&replicate.CstorBackend{C: c},
+		&replicate.ZFSLocalPVBackend{},
+		&replicate.HwameistorBackend{CS: c.HwameistorCS, Ns: c.Ns},
+	)
+	return registry.Get(engine)
+}
+
+func newCmdVolumeReplicateList(engine *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [volume]",
+		Short:   "List the replicas of a volume and their replication state",
+		Args:    cobra.ExactArgs(1),
+		Example: "openebsctl volume replicate list pvc-1234 --engine cstor",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := registryFor(*engine)
+			util.CheckErr(err, util.Fatal)
+			replicas, err := b.List(args[0])
+			util.CheckErr(err, util.Fatal)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NAME\tPOOL/NODE\tSTATE")
+			for _, r := range replicas {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", r.Name, r.Pool, r.State)
+			}
+			w.Flush()
+		},
+	}
+}
+
+func newCmdVolumeReplicatePromote(engine *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "promote [volume] [replica]",
+		Short:   "Promote a replica to primary",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl volume replicate promote pvc-1234 pvc-1234-cspi-1 --engine cstor",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := registryFor(*engine)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(b.Promote(args[0], args[1]), util.Fatal)
+		},
+	}
+}
+
+func newCmdVolumeReplicateDemote(engine *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "demote [volume] [replica]",
+		Short:   "Demote a replica to secondary",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl volume replicate demote pvc-1234 pvc-1234-cspi-1 --engine cstor",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := registryFor(*engine)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(b.Demote(args[0], args[1]), util.Fatal)
+		},
+	}
+}
+
+func newCmdVolumeReplicateResync(engine *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "resync [volume] [replica]",
+		Short:   "Trigger a rebuild of a replica from its healthy peers",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl volume replicate resync pvc-1234 pvc-1234-cspi-1 --engine cstor",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := registryFor(*engine)
+			util.CheckErr(err, util.Fatal)
+			util.CheckErr(b.Resync(args[0], args[1]), util.Fatal)
+		},
+	}
+}
+
+func newCmdVolumeReplicateStatus(engine *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "status [volume] [replica]",
+		Short:   "Show the common replication state of a replica",
+		Args:    cobra.ExactArgs(2),
+		Example: "openebsctl volume replicate status pvc-1234 pvc-1234-cspi-1 --engine cstor",
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := registryFor(*engine)
+			util.CheckErr(err, util.Fatal)
+			state, err := b.Status(args[0], args[1])
+			util.CheckErr(err, util.Fatal)
+			fmt.Println(state)
+		},
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cvr holds CLI-facing helpers for operating directly on
+// CStorVolumeReplica resources, as opposed to the pkg/cstor volume/pool
+// helpers which operate at the CStorVolume/CStorPoolInstance level.
+package cvr
+
+import (
+	"fmt"
+	"time"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultFinalizer is the finalizer the cstor-pool-mgmt controller sets on
+// every CVR, and the one that most commonly gets stuck on an orphaned
+// replica whose pool is already gone.
+const defaultFinalizer = "cstorvolumereplica.openebs.io/finalizer"
+
+// StuckCVR is a CVR flagged by Find as a finalizer-cleanup candidate, along
+// with the reason it was flagged.
+type StuckCVR struct {
+	CVR    cstorv1.CStorVolumeReplica
+	Reason string
+}
+
+// Find lists CVRs in ns (all namespaces if allNamespaces is true) whose
+// Phase is CVRStatusDeletionFailed, or which have a DeletionTimestamp older
+// than olderThan.
+func Find(c *client.K8sClient, ns string, allNamespaces bool, olderThan time.Duration) ([]StuckCVR, error) {
+	if allNamespaces {
+		ns = ""
+	}
+	list, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CVRs: %w", err)
+	}
+
+	var stuck []StuckCVR
+	for _, cvr := range list.Items {
+		switch {
+		case cvr.Status.Phase == cstorv1.CVRStatusDeletionFailed:
+			stuck = append(stuck, StuckCVR{cvr, "phase is DeletionFailed"})
+		case cvr.DeletionTimestamp != nil && time.Since(cvr.DeletionTimestamp.Time) > olderThan:
+			stuck = append(stuck, StuckCVR{cvr, fmt.Sprintf("deletion has been pending for %s", time.Since(cvr.DeletionTimestamp.Time).Round(time.Second))})
+		}
+	}
+	return stuck, nil
+}
+
+// PoolIsSafeToCleanup reports whether the CSPI backing cvr is either
+// missing or not Healthy, which is the safety condition required before a
+// stuck CVR's finalizers may be stripped: if the pool is up and healthy,
+// the CVR more likely needs a real fix than a forced removal.
+func PoolIsSafeToCleanup(c *client.K8sClient, cvr cstorv1.CStorVolumeReplica) (bool, error) {
+	poolName := cvr.Labels["cstorpoolinstance.openebs.io/name"]
+	if poolName == "" {
+		return true, nil
+	}
+	cspi, err := c.OpenebsCS.CstorV1().CStorPoolInstances(cvr.Namespace).Get(poolName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// Missing pool is the common disaster-recovery case.
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get CSPI %s: %w", poolName, err)
+	}
+	return cspi.Status.Phase != cstorv1.CStorPoolStatusOnline, nil
+}
+
+// filterFinalizer returns finalizers with every occurrence of target
+// removed, without mutating the input slice's backing array.
+func filterFinalizer(finalizers []string, target string) []string {
+	kept := make([]string, 0, len(finalizers))
+	for _, f := range finalizers {
+		if f != target {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// StripFinalizer removes finalizer (defaulting to defaultFinalizer when
+// empty) from cvr. When dryRun is true, cvr is left completely untouched and
+// no API call is made.
+func StripFinalizer(c *client.K8sClient, cvr cstorv1.CStorVolumeReplica, finalizer string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+	if finalizer == "" {
+		finalizer = defaultFinalizer
+	}
+	cvr.Finalizers = filterFinalizer(cvr.Finalizers, finalizer)
+	if _, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(cvr.Namespace).Update(&cvr); err != nil {
+		return fmt.Errorf("failed to strip finalizer from %s/%s: %w", cvr.Namespace, cvr.Name, err)
+	}
+	return nil
+}
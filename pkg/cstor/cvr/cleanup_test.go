@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cvr
+
+import "testing"
+
+func TestFilterFinalizerRemovesOnlyTarget(t *testing.T) {
+	in := []string{"a.openebs.io/finalizer", defaultFinalizer, "b.openebs.io/finalizer"}
+	got := filterFinalizer(in, defaultFinalizer)
+	want := []string{"a.openebs.io/finalizer", "b.openebs.io/finalizer"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestFilterFinalizerDoesNotMutateInput(t *testing.T) {
+	in := []string{defaultFinalizer, "b.openebs.io/finalizer"}
+	_ = filterFinalizer(in, defaultFinalizer)
+	if in[0] != defaultFinalizer || in[1] != "b.openebs.io/finalizer" {
+		t.Fatalf("input slice was mutated: %v", in)
+	}
+}
+
+func TestFilterFinalizerNoMatchReturnsAll(t *testing.T) {
+	in := []string{"a.openebs.io/finalizer"}
+	got := filterFinalizer(in, defaultFinalizer)
+	if len(got) != 1 || got[0] != "a.openebs.io/finalizer" {
+		t.Fatalf("got %v, want unchanged input", got)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebuild
+
+import (
+	"fmt"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FindHealthyPeer returns the name of any Healthy replica of volName other
+// than exclude, to use as the rebuild target in Poll.
+func FindHealthyPeer(c *client.K8sClient, volName, exclude string) (string, error) {
+	list, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).List(metav1.ListOptions{
+		LabelSelector: "openebs.io/persistent-volume=" + volName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list CVRs for volume %s: %w", volName, err)
+	}
+	for _, cvr := range list.Items {
+		if cvr.Name != exclude && cvr.Status.Phase == cstorv1.CVRStatusOnline {
+			return cvr.Name, nil
+		}
+	}
+	return "", fmt.Errorf("no healthy peer replica found for volume %s", volName)
+}
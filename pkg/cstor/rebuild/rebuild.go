@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rebuild estimates rebuild progress and ETA for cStor volume
+// replicas that are catching up to a healthy peer, by sampling
+// Status.Capacity.Used over time. The CVR phase enum alone tells an
+// operator *that* a replica is rebuilding, not how long it has left; this
+// package fills that gap.
+package rebuild
+
+import (
+	"fmt"
+	"time"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// rebuildingPhases are the phases this package samples progress for.
+var rebuildingPhases = map[cstorv1.CStorVolumeReplicaPhase]bool{
+	cstorv1.CVRStatusRebuilding:               true,
+	cstorv1.CVRStatusReconstructingNewReplica: true,
+	cstorv1.CVRStatusNewReplicaDegraded:       true,
+}
+
+// Estimate is one sample's view of a rebuilding replica's progress.
+type Estimate struct {
+	CVRName        string
+	Phase          cstorv1.CStorVolumeReplicaPhase
+	Used           uint64
+	PeerReferenced uint64
+	ThroughputBps  float64
+	ETA            time.Duration
+	// Stalled is true when throughput is <= 0 over the current window.
+	Stalled bool
+	// Complete is true once the phase transitions to CVRStatusOnline.
+	Complete bool
+}
+
+// sample is the rolling window kept per-CVR between Poll calls.
+type sample struct {
+	at   time.Time
+	used uint64
+}
+
+// Tracker samples rebuild progress across calls, keyed by CVR name. A
+// Tracker is not safe for concurrent use.
+type Tracker struct {
+	windows map[string]sample
+}
+
+// NewTracker returns an empty Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{windows: map[string]sample{}}
+}
+
+// Poll fetches cvrName's current status and, if it is in a rebuilding
+// phase, compares it against the last sample in the window to compute
+// throughput and ETA against the largest LogicalReferenced among the
+// healthy peer's snapshots. A phase regression back to Degraded resets
+// the window so stale samples don't skew the next estimate.
+func (t *Tracker) Poll(c *client.K8sClient, cvrName, peerCVRName string) (*Estimate, error) {
+	cvr, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).Get(cvrName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CVR %s: %w", cvrName, err)
+	}
+
+	if cvr.Status.Phase == cstorv1.CVRStatusOnline {
+		delete(t.windows, cvrName)
+		return &Estimate{CVRName: cvrName, Phase: cvr.Status.Phase, Complete: true}, nil
+	}
+	if cvr.Status.Phase == cstorv1.CVRStatusDegraded {
+		delete(t.windows, cvrName)
+	}
+	if !rebuildingPhases[cvr.Status.Phase] {
+		return &Estimate{CVRName: cvrName, Phase: cvr.Status.Phase}, nil
+	}
+
+	used, err := parseBytes(cvr.Status.Capacity.Used)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse used capacity for %s: %w", cvrName, err)
+	}
+
+	peer, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).Get(peerCVRName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get healthy peer %s: %w", peerCVRName, err)
+	}
+	peerReferenced := maxLogicalReferenced(peer)
+
+	now := time.Now()
+	prev, ok := t.windows[cvrName]
+	t.windows[cvrName] = sample{at: now, used: used}
+
+	est := &Estimate{CVRName: cvrName, Phase: cvr.Status.Phase, Used: used, PeerReferenced: peerReferenced}
+	if !ok {
+		est.Stalled = true
+		return est, nil
+	}
+
+	est.ThroughputBps, est.ETA, est.Stalled = computeThroughputAndETA(prev.used, used, now.Sub(prev.at).Seconds(), peerReferenced)
+	return est, nil
+}
+
+// computeThroughputAndETA has no cluster dependency so it can be exercised
+// directly by tests. It reports stalled when elapsed or the observed delta
+// is non-positive, and an ETA of zero once used has already reached or
+// passed peerReferenced.
+func computeThroughputAndETA(prevUsed, used uint64, elapsed float64, peerReferenced uint64) (throughputBps float64, eta time.Duration, stalled bool) {
+	if elapsed <= 0 || used <= prevUsed {
+		return 0, 0, true
+	}
+	throughputBps = float64(used-prevUsed) / elapsed
+	var remaining uint64
+	if used < peerReferenced {
+		remaining = peerReferenced - used
+	}
+	eta = time.Duration(float64(remaining)/throughputBps) * time.Second
+	return throughputBps, eta, false
+}
+
+// maxLogicalReferenced returns the largest LogicalReferenced value among a
+// peer's snapshots, used as the rebuild target size.
+func maxLogicalReferenced(peer *cstorv1.CStorVolumeReplica) uint64 {
+	var max uint64
+	for _, snap := range peer.Status.Snapshots {
+		if snap.LogicalReferenced > max {
+			max = snap.LogicalReferenced
+		}
+	}
+	return max
+}
+
+// parseBytes parses a Kubernetes resource quantity string (as stored in
+// CStorVolumeReplicaCapacityDetails) into bytes.
+func parseBytes(qty string) (uint64, error) {
+	if qty == "" {
+		return 0, nil
+	}
+	q, err := resource.ParseQuantity(qty)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(q.Value()), nil
+}
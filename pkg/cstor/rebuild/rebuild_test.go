@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rebuild
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeThroughputAndETA(t *testing.T) {
+	throughput, eta, stalled := computeThroughputAndETA(100, 200, 10, 1100)
+	if stalled {
+		t.Fatal("did not expect stalled")
+	}
+	if throughput != 10 {
+		t.Fatalf("expected 10 B/s, got %v", throughput)
+	}
+	if eta != 90*time.Second {
+		t.Fatalf("expected 90s ETA, got %v", eta)
+	}
+}
+
+func TestComputeThroughputAndETAStalledOnNoProgress(t *testing.T) {
+	if _, _, stalled := computeThroughputAndETA(200, 200, 10, 1100); !stalled {
+		t.Fatal("expected stalled when used does not increase")
+	}
+}
+
+func TestComputeThroughputAndETAStalledOnNonPositiveElapsed(t *testing.T) {
+	if _, _, stalled := computeThroughputAndETA(100, 200, 0, 1100); !stalled {
+		t.Fatal("expected stalled when elapsed is zero")
+	}
+}
+
+func TestComputeThroughputAndETAZeroWhenAlreadyCaughtUp(t *testing.T) {
+	_, eta, stalled := computeThroughputAndETA(900, 1000, 10, 1000)
+	if stalled {
+		t.Fatal("did not expect stalled")
+	}
+	if eta != 0 {
+		t.Fatalf("expected 0 ETA once used has caught up to peerReferenced, got %v", eta)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"fmt"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cspiPoolLabel is the label CSPI instances carry pointing back at the CSPC
+// that owns them.
+const cspiPoolLabel = "openebs.io/cstor-pool-cluster"
+
+// replicaPoolLabel is the label a CVR carries naming the CSPI it lives on.
+const replicaPoolLabel = "cstorpoolinstance.openebs.io/name"
+
+// cvrsForVolume lists every CVR belonging to volName.
+func cvrsForVolume(c *client.K8sClient, volName string) ([]cstorv1.CStorVolumeReplica, error) {
+	list, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).List(metav1.ListOptions{
+		LabelSelector: "openebs.io/persistent-volume=" + volName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CVRs for volume %s: %w", volName, err)
+	}
+	return list.Items, nil
+}
+
+// cspiOwnerCSPC returns the CStorPoolCluster that owns the named CSPI.
+func cspiOwnerCSPC(c *client.K8sClient, cspiName string) (*cstorv1.CStorPoolCluster, error) {
+	cspi, err := c.OpenebsCS.CstorV1().CStorPoolInstances(c.Ns).Get(cspiName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CSPI %s: %w", cspiName, err)
+	}
+	cspcName := cspi.Labels[cspiPoolLabel]
+	if cspcName == "" {
+		return nil, fmt.Errorf("CSPI %s has no owning CSPC label %s", cspiName, cspiPoolLabel)
+	}
+	cspc, err := c.OpenebsCS.CstorV1().CStorPoolClusters(c.Ns).Get(cspcName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CSPC %s: %w", cspcName, err)
+	}
+	return cspc, nil
+}
+
+// newCVR creates a new CStorVolumeReplica on cspiName, cloning the
+// provisioning spec (target IP, capacity, workers, compression, block size)
+// from template, an existing replica of the same volume. The template's
+// phase is not checked.
+func newCVR(c *client.K8sClient, volName string, template cstorv1.CStorVolumeReplica, cspiName string) (*cstorv1.CStorVolumeReplica, error) {
+	cvr := &cstorv1.CStorVolumeReplica{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", volName, cspiName),
+			Namespace: c.Ns,
+			Labels: map[string]string{
+				"openebs.io/persistent-volume": volName,
+				replicaPoolLabel:               cspiName,
+			},
+		},
+		Spec: cstorv1.CStorVolumeReplicaSpec{
+			TargetIP:    template.Spec.TargetIP,
+			Capacity:    template.Spec.Capacity,
+			ZvolWorkers: template.Spec.ZvolWorkers,
+			Compression: template.Spec.Compression,
+			BlockSize:   template.Spec.BlockSize,
+		},
+		Status: cstorv1.CStorVolumeReplicaStatus{
+			Phase: cstorv1.CVRStatusNewReplicaDegraded,
+		},
+	}
+	return c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).Create(cvr)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scale implements the replica scaling workflow for cStor volumes,
+// mirroring the scaleVolumeReplicas flow that the upstream cvc-operator
+// drives from CStorVolumeConfig changes, but triggered directly from the CLI.
+package scale
+
+import (
+	"fmt"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Scale grows or shrinks the replica set of the CStorVolumeConfig/CStorVolume
+// identified by volName to replicaCount, placing any newly created replicas
+// on the CSPIs named in cspiNames. It refuses to scale down unless every
+// existing CVR is Healthy, and refuses to scale up beyond the number of
+// pools available in the CSPC backing the volume. Both directions patch the
+// CVC's Spec.Policy.ReplicaPoolInfo to match, so the CVC stays the source of
+// truth the cvc-operator's own reconciler expects, instead of drifting out
+// of sync with the CVRs this command creates or removes directly.
+func Scale(c *client.K8sClient, volName string, replicaCount int, cspiNames []string) error {
+	cvrs, err := cvrsForVolume(c, volName)
+	if err != nil {
+		return err
+	}
+	cvc, err := c.OpenebsCS.CstorV1().CStorVolumeConfigs(c.Ns).Get(volName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get CStorVolumeConfig %s: %w", volName, err)
+	}
+
+	current := len(cvrs)
+	switch {
+	case replicaCount == current:
+		return fmt.Errorf("volume %s already has %d replicas", volName, current)
+	case replicaCount < current:
+		return scaleDown(c, cvc, cvrs, current-replicaCount)
+	default:
+		return scaleUp(c, cvc, cvrs, cspiNames, replicaCount-current)
+	}
+}
+
+// scaleDown removes count replicas from the volume, after verifying that
+// every existing CVR is reporting CVRStatusOnline. Scaling down an already
+// degraded volume risks dropping the last healthy copy of the data, so the
+// whole operation is rejected if any replica is unhealthy.
+func scaleDown(c *client.K8sClient, cvc *cstorv1.CStorVolumeConfig, cvrs []cstorv1.CStorVolumeReplica, count int) error {
+	if err := validateScaleDown(cvrs, count); err != nil {
+		return err
+	}
+
+	toRemove := cvrs[:count]
+	removedPools := make([]string, 0, count)
+	for _, cvr := range toRemove {
+		removedPools = append(removedPools, cvr.Labels[replicaPoolLabel])
+	}
+
+	cvc.Spec.Policy.ReplicaPoolInfo = removePoolNames(cvc.Spec.Policy.ReplicaPoolInfo, removedPools)
+	if _, err := c.OpenebsCS.CstorV1().CStorVolumeConfigs(c.Ns).Update(cvc); err != nil {
+		return fmt.Errorf("failed to update CVC %s: %w", cvc.Name, err)
+	}
+
+	for _, cvr := range toRemove {
+		if err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).Delete(cvr.Name, &metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("failed to remove replica %s: %w", cvr.Name, err)
+		}
+	}
+	return nil
+}
+
+// scaleUp provisions count new CVRs for the volume on the given CSPIs. It
+// rejects requests that would need more pools than the CSPC has, since each
+// replica must live on a distinct pool instance, and requests with no
+// existing replica to use as a provisioning template.
+func scaleUp(c *client.K8sClient, cvc *cstorv1.CStorVolumeConfig, existing []cstorv1.CStorVolumeReplica, cspiNames []string, count int) error {
+	if err := validateScaleUp(len(existing), len(cspiNames), count); err != nil {
+		return err
+	}
+
+	cspc, err := cspiOwnerCSPC(c, cspiNames[0])
+	if err != nil {
+		return err
+	}
+	if total := len(existing) + count; total > len(cspc.Spec.Pools) {
+		return fmt.Errorf("cannot scale %s to %d replicas: CSPC %s only has %d pools", cvc.Name, total, cspc.Name, len(cspc.Spec.Pools))
+	}
+
+	target := cspiNames[:count]
+	cvc.Spec.Policy.ReplicaPoolInfo = appendPoolNames(cvc.Spec.Policy.ReplicaPoolInfo, target)
+	if _, err := c.OpenebsCS.CstorV1().CStorVolumeConfigs(c.Ns).Update(cvc); err != nil {
+		return fmt.Errorf("failed to update CVC %s: %w", cvc.Name, err)
+	}
+
+	for _, cspiName := range target {
+		if _, err := newCVR(c, cvc.Name, existing[0], cspiName); err != nil {
+			return fmt.Errorf("failed to create replica on %s: %w", cspiName, err)
+		}
+	}
+	return nil
+}
+
+// validateScaleDown applies the scale-down safety checks without talking to
+// the cluster, so it can be exercised directly by unit tests.
+func validateScaleDown(cvrs []cstorv1.CStorVolumeReplica, count int) error {
+	for _, cvr := range cvrs {
+		if cvr.Status.Phase != cstorv1.CVRStatusOnline {
+			return fmt.Errorf("cannot scale down: replica %s is %s, want all replicas %s",
+				cvr.Name, cvr.Status.Phase, cstorv1.CVRStatusOnline)
+		}
+	}
+	if count <= 0 {
+		return fmt.Errorf("scale-down count must be positive, got %d", count)
+	}
+	if count > len(cvrs)-1 {
+		return fmt.Errorf("cannot scale down to zero replicas")
+	}
+	return nil
+}
+
+// validateScaleUp applies the scale-up safety checks without talking to the
+// cluster, so it can be exercised directly by unit tests.
+func validateScaleUp(existingCount, cspiCount, count int) error {
+	if existingCount == 0 {
+		return fmt.Errorf("cannot scale up: no existing replicas found to use as a provisioning template")
+	}
+	if cspiCount < count {
+		return fmt.Errorf("not enough target CSPIs (%d) to add %d replicas", cspiCount, count)
+	}
+	return nil
+}
+
+// removePoolNames returns info with every entry whose PoolName is in remove
+// dropped.
+func removePoolNames(info []cstorv1.ReplicaPoolInfo, remove []string) []cstorv1.ReplicaPoolInfo {
+	removeSet := make(map[string]bool, len(remove))
+	for _, name := range remove {
+		removeSet[name] = true
+	}
+	kept := make([]cstorv1.ReplicaPoolInfo, 0, len(info))
+	for _, p := range info {
+		if !removeSet[p.PoolName] {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// appendPoolNames returns info with one ReplicaPoolInfo entry appended per
+// name in add.
+func appendPoolNames(info []cstorv1.ReplicaPoolInfo, add []string) []cstorv1.ReplicaPoolInfo {
+	out := make([]cstorv1.ReplicaPoolInfo, len(info), len(info)+len(add))
+	copy(out, info)
+	for _, name := range add {
+		out = append(out, cstorv1.ReplicaPoolInfo{PoolName: name})
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scale
+
+import (
+	"testing"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+)
+
+func cvrWithPhase(name string, phase cstorv1.CStorVolumeReplicaPhase) cstorv1.CStorVolumeReplica {
+	cvr := cstorv1.CStorVolumeReplica{}
+	cvr.Name = name
+	cvr.Status.Phase = phase
+	return cvr
+}
+
+func TestValidateScaleDownRejectsUnhealthyReplica(t *testing.T) {
+	cvrs := []cstorv1.CStorVolumeReplica{
+		cvrWithPhase("cvr-1", cstorv1.CVRStatusOnline),
+		cvrWithPhase("cvr-2", cstorv1.CVRStatusDegraded),
+	}
+	if err := validateScaleDown(cvrs, 1); err == nil {
+		t.Fatal("expected an error when a replica is not Healthy")
+	}
+}
+
+func TestValidateScaleDownRejectsZeroReplicaTarget(t *testing.T) {
+	cvrs := []cstorv1.CStorVolumeReplica{
+		cvrWithPhase("cvr-1", cstorv1.CVRStatusOnline),
+	}
+	if err := validateScaleDown(cvrs, 1); err == nil {
+		t.Fatal("expected an error when scaling down to zero replicas")
+	}
+}
+
+func TestValidateScaleDownAllowsHealthyPartialScaleDown(t *testing.T) {
+	cvrs := []cstorv1.CStorVolumeReplica{
+		cvrWithPhase("cvr-1", cstorv1.CVRStatusOnline),
+		cvrWithPhase("cvr-2", cstorv1.CVRStatusOnline),
+		cvrWithPhase("cvr-3", cstorv1.CVRStatusOnline),
+	}
+	if err := validateScaleDown(cvrs, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateScaleUpRejectsNoTemplateReplica(t *testing.T) {
+	if err := validateScaleUp(0, 2, 1); err == nil {
+		t.Fatal("expected an error when there is no existing replica to use as a template")
+	}
+}
+
+func TestValidateScaleUpRejectsTooFewCSPIs(t *testing.T) {
+	if err := validateScaleUp(1, 1, 2); err == nil {
+		t.Fatal("expected an error when fewer CSPIs are given than replicas requested")
+	}
+}
+
+func TestValidateScaleUpAllowsEnoughCSPIs(t *testing.T) {
+	if err := validateScaleUp(1, 2, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestRemovePoolNames(t *testing.T) {
+	info := []cstorv1.ReplicaPoolInfo{{PoolName: "cspi-1"}, {PoolName: "cspi-2"}, {PoolName: "cspi-3"}}
+	got := removePoolNames(info, []string{"cspi-2"})
+	if len(got) != 2 || got[0].PoolName != "cspi-1" || got[1].PoolName != "cspi-3" {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+}
+
+func TestAppendPoolNames(t *testing.T) {
+	info := []cstorv1.ReplicaPoolInfo{{PoolName: "cspi-1"}}
+	got := appendPoolNames(info, []string{"cspi-2", "cspi-3"})
+	if len(got) != 3 || got[1].PoolName != "cspi-2" || got[2].PoolName != "cspi-3" {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"fmt"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pendingCreateAnnotation and pendingDeleteAnnotation signal snapshot
+// intent to the cstor-pool-mgmt controller. Snapshots.Status is
+// reconciler-owned, so Create and Delete below only ever touch CVR
+// annotations (a regular Update), never Status.
+const (
+	pendingCreateAnnotation = "openebs.io/snapshot-create"
+	pendingDeleteAnnotation = "openebs.io/snapshot-delete"
+)
+
+// Create signals snapName as a create request on every replica of volName,
+// by annotating each CVR; the cstor-pool-mgmt sidecar picks up the
+// annotation, takes the zfs snapshot, and reports it back under
+// Status.Snapshots once done.
+func Create(c *client.K8sClient, volName, snapName string) error {
+	cvrs, err := cvrsForVolume(c, volName)
+	if err != nil {
+		return err
+	}
+	for _, cvr := range cvrs {
+		if cvr.Annotations == nil {
+			cvr.Annotations = map[string]string{}
+		}
+		cvr.Annotations[pendingCreateAnnotation] = snapName
+		if _, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(cvr.Namespace).Update(&cvr); err != nil {
+			return fmt.Errorf("failed to request snapshot %s on %s: %w", snapName, cvr.Name, err)
+		}
+	}
+	return nil
+}
+
+// Delete signals snapName as a delete request on every replica of volName,
+// the same way Create signals a create request.
+func Delete(c *client.K8sClient, volName, snapName string) error {
+	cvrs, err := cvrsForVolume(c, volName)
+	if err != nil {
+		return err
+	}
+	for _, cvr := range cvrs {
+		if cvr.Annotations == nil {
+			cvr.Annotations = map[string]string{}
+		}
+		cvr.Annotations[pendingDeleteAnnotation] = snapName
+		if _, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(cvr.Namespace).Update(&cvr); err != nil {
+			return fmt.Errorf("failed to request deletion of snapshot %s on %s: %w", snapName, cvr.Name, err)
+		}
+	}
+	return nil
+}
+
+// Clone provisions a new PVC named pvcName whose backing CVRs are restored
+// from snapName on volName. It tags each new CVR with CloneEnableKEY,
+// SourceVolumeKey and SnapshotNameKey so the cstor-pool-mgmt controller
+// restores the zfs dataset from the snapshot instead of creating an empty
+// one, then creates the PVC itself so provisioning actually proceeds.
+func Clone(c *client.K8sClient, volName, snapName, pvcName string) (*corev1.PersistentVolumeClaim, error) {
+	info, err := Describe(c, volName, snapName)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.PendingOn) > 0 {
+		return nil, fmt.Errorf("snapshot %s is still pending on %v; cannot clone from it yet", snapName, info.PendingOn)
+	}
+
+	cvrs, err := cvrsForVolume(c, volName)
+	if err != nil {
+		return nil, err
+	}
+	for i, cvr := range cvrs {
+		clone := cvr.DeepCopy()
+		clone.ObjectMeta = metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", pvcName, i),
+			Namespace: cvr.Namespace,
+			Labels: map[string]string{
+				"openebs.io/persistent-volume":      pvcName,
+				"cstorpoolinstance.openebs.io/name": cvr.Labels["cstorpoolinstance.openebs.io/name"],
+			},
+			Annotations: map[string]string{
+				string(cstorv1.CloneEnableKEY):  "true",
+				string(cstorv1.SourceVolumeKey): volName,
+				string(cstorv1.SnapshotNameKey): snapName,
+			},
+		}
+		clone.Status = cstorv1.CStorVolumeReplicaStatus{Phase: cstorv1.CVRStatusInit}
+		if _, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(clone.Namespace).Create(clone); err != nil {
+			return nil, fmt.Errorf("failed to create clone replica %s: %w", clone.Name, err)
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: c.Ns,
+			Annotations: map[string]string{
+				string(cstorv1.SourceVolumeKey): volName,
+				string(cstorv1.SnapshotNameKey): snapName,
+			},
+		},
+	}
+	created, err := c.K8sCS.CoreV1().PersistentVolumeClaims(c.Ns).Create(pvc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PVC %s: %w", pvcName, err)
+	}
+	return created, nil
+}
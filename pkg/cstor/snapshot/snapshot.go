@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot drives cStor snapshot and clone workflows off the
+// Snapshots/PendingSnapshots metadata that CStorVolumeReplica.Status already
+// carries, so users get a CLI-level view without hand-crafting VolumeSnapshot
+// CRs.
+package snapshot
+
+import (
+	"fmt"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Info summarizes one snapshot across all replicas of a volume.
+type Info struct {
+	Name    string
+	VolName string
+	// PendingOn lists the replicas that do not yet have this snapshot,
+	// per their PendingSnapshots entry.
+	PendingOn []string
+	// LogicalReferenced maps replica name to that replica's view of the
+	// snapshot's logical-referenced size, so callers can spot divergence.
+	LogicalReferenced map[string]uint64
+}
+
+// List aggregates the Snapshots (and PendingSnapshots) maps across every CVR
+// of volName into one Info per snapshot name.
+func List(c *client.K8sClient, volName string) ([]Info, error) {
+	cvrs, err := cvrsForVolume(c, volName)
+	if err != nil {
+		return nil, err
+	}
+	return aggregate(volName, cvrs), nil
+}
+
+// aggregate builds one Info per snapshot name out of a volume's CVRs. It
+// has no cluster dependency so it can be exercised directly by tests.
+func aggregate(volName string, cvrs []cstorv1.CStorVolumeReplica) []Info {
+	byName := map[string]*Info{}
+	get := func(name string) *Info {
+		if info, ok := byName[name]; ok {
+			return info
+		}
+		info := &Info{Name: name, VolName: volName, LogicalReferenced: map[string]uint64{}}
+		byName[name] = info
+		return info
+	}
+
+	for _, cvr := range cvrs {
+		for name, snap := range cvr.Status.Snapshots {
+			info := get(name)
+			info.LogicalReferenced[cvr.Name] = snap.LogicalReferenced
+		}
+		for name := range cvr.Status.PendingSnapshots {
+			info := get(name)
+			info.PendingOn = append(info.PendingOn, cvr.Name)
+		}
+	}
+
+	out := make([]Info, 0, len(byName))
+	for _, info := range byName {
+		out = append(out, *info)
+	}
+	return out
+}
+
+// Describe returns the single Info for snapName on volName, for callers
+// that want per-replica LogicalReferenced divergence detail.
+func Describe(c *client.K8sClient, volName, snapName string) (*Info, error) {
+	infos, err := List(c, volName)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.Name == snapName {
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("snapshot %s not found on volume %s", snapName, volName)
+}
+
+func cvrsForVolume(c *client.K8sClient, volName string) ([]cstorv1.CStorVolumeReplica, error) {
+	list, err := c.OpenebsCS.CstorV1().CStorVolumeReplicas(c.Ns).List(metav1.ListOptions{
+		LabelSelector: "openebs.io/persistent-volume=" + volName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CVRs for volume %s: %w", volName, err)
+	}
+	return list.Items, nil
+}
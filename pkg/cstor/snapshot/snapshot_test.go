@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"testing"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+)
+
+func TestAggregateMergesAcrossReplicas(t *testing.T) {
+	cvrs := []cstorv1.CStorVolumeReplica{
+		{
+			Status: cstorv1.CStorVolumeReplicaStatus{
+				Snapshots: map[string]cstorv1.CStorSnapshotInfo{
+					"snap-1": {LogicalReferenced: 100},
+				},
+			},
+		},
+		{
+			Status: cstorv1.CStorVolumeReplicaStatus{
+				Snapshots: map[string]cstorv1.CStorSnapshotInfo{
+					"snap-1": {LogicalReferenced: 120},
+				},
+				PendingSnapshots: map[string]cstorv1.CStorSnapshotInfo{
+					"snap-2": {},
+				},
+			},
+		},
+	}
+	cvrs[0].Name = "cvr-0"
+	cvrs[1].Name = "cvr-1"
+
+	infos := aggregate("pvc-1234", cvrs)
+	if len(infos) != 2 {
+		t.Fatalf("expected 2 snapshots, got %d", len(infos))
+	}
+
+	byName := map[string]Info{}
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+
+	snap1 := byName["snap-1"]
+	if snap1.LogicalReferenced["cvr-0"] != 100 || snap1.LogicalReferenced["cvr-1"] != 120 {
+		t.Fatalf("unexpected divergence data: %+v", snap1.LogicalReferenced)
+	}
+	if len(snap1.PendingOn) != 0 {
+		t.Fatalf("snap-1 should not be pending, got %v", snap1.PendingOn)
+	}
+
+	snap2 := byName["snap-2"]
+	if len(snap2.PendingOn) != 1 || snap2.PendingOn[0] != "cvr-1" {
+		t.Fatalf("expected snap-2 pending on cvr-1, got %v", snap2.PendingOn)
+	}
+}
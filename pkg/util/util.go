@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package util holds small helpers shared across openebsctl commands.
+package util
+
+import (
+	"fmt"
+	"os"
+)
+
+// ExitHandler decides what happens to a non-nil error reported via CheckErr.
+type ExitHandler func(msg string)
+
+// Fatal prints msg to stderr and exits the process with a non-zero code.
+// It is the ExitHandler nearly every command passes to CheckErr.
+func Fatal(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+	os.Exit(1)
+}
+
+// CheckErr invokes handle with err's message if err is non-nil, and is a
+// no-op otherwise.
+func CheckErr(err error, handle ExitHandler) {
+	if err != nil {
+		handle(err.Error())
+	}
+}
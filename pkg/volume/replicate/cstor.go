@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicate
+
+import (
+	"fmt"
+	"time"
+
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+	"github.com/openebs/openebsctl/pkg/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// targetConnAnnotation is bounced to make the target controller reconnect
+// to a replica, which is what drives a rebuild from the iSCSI target side.
+const targetConnAnnotation = "openebs.io/target-connection"
+
+// cstorStateByPhase maps CStorVolumeReplicaPhase onto the common
+// ReplicationState enum.
+var cstorStateByPhase = map[cstorv1.CStorVolumeReplicaPhase]ReplicationState{
+	cstorv1.CVRStatusOnline:                   StateHealthy,
+	cstorv1.CVRStatusDegraded:                 StateDegraded,
+	cstorv1.CVRStatusRebuilding:               StateResyncing,
+	cstorv1.CVRStatusReconstructingNewReplica: StateResyncing,
+	cstorv1.CVRStatusNewReplicaDegraded:       StateResyncing,
+	cstorv1.CVRStatusOffline:                  StateOffline,
+}
+
+// CstorBackend is the Backend implementation for cStor, backed by
+// CStorVolumeReplica resources.
+type CstorBackend struct {
+	C *client.K8sClient
+}
+
+// Name implements Backend.
+func (b *CstorBackend) Name() string { return "cstor" }
+
+// List implements Backend.
+func (b *CstorBackend) List(volName string) ([]Replica, error) {
+	cvrs, err := b.cvrs(volName)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Replica, 0, len(cvrs))
+	for _, cvr := range cvrs {
+		out = append(out, Replica{
+			Name:  cvr.Name,
+			Pool:  cvr.Labels["cstorpoolinstance.openebs.io/name"],
+			State: cstorStateByPhase[cvr.Status.Phase],
+		})
+	}
+	return out, nil
+}
+
+// Promote is not a cStor concept: every Healthy replica already serves
+// reads/writes through the shared iSCSI target, so there is no single
+// active replica to promote. It is implemented as a no-op validation that
+// the replica exists and is Healthy.
+func (b *CstorBackend) Promote(volName, replicaName string) error {
+	state, err := b.Status(volName, replicaName)
+	if err != nil {
+		return err
+	}
+	if state != StateHealthy {
+		return fmt.Errorf("cannot promote %s: replica is %s, not %s", replicaName, state, StateHealthy)
+	}
+	return nil
+}
+
+// Demote is equally a no-op for cStor, for the same reason as Promote.
+func (b *CstorBackend) Demote(volName, replicaName string) error {
+	_, err := b.Status(volName, replicaName)
+	return err
+}
+
+// Resync triggers a rebuild of replicaName by bouncing its
+// target-connection annotation to a fresh timestamp, so the annotation
+// changes on every call and the target controller reconnects and kicks off
+// a rebuild against the healthy peers.
+func (b *CstorBackend) Resync(volName, replicaName string) error {
+	cvr, err := b.C.OpenebsCS.CstorV1().CStorVolumeReplicas(b.C.Ns).Get(replicaName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get CVR %s: %w", replicaName, err)
+	}
+	if cvr.Annotations == nil {
+		cvr.Annotations = map[string]string{}
+	}
+	cvr.Annotations[targetConnAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	if _, err := b.C.OpenebsCS.CstorV1().CStorVolumeReplicas(b.C.Ns).Update(cvr); err != nil {
+		return fmt.Errorf("failed to bounce target connection on %s: %w", replicaName, err)
+	}
+	return nil
+}
+
+// Status implements Backend.
+func (b *CstorBackend) Status(volName, replicaName string) (ReplicationState, error) {
+	cvr, err := b.C.OpenebsCS.CstorV1().CStorVolumeReplicas(b.C.Ns).Get(replicaName, metav1.GetOptions{})
+	if err != nil {
+		return StateUnknown, fmt.Errorf("failed to get CVR %s: %w", replicaName, err)
+	}
+	state, ok := cstorStateByPhase[cvr.Status.Phase]
+	if !ok {
+		return StateUnknown, nil
+	}
+	return state, nil
+}
+
+func (b *CstorBackend) cvrs(volName string) ([]cstorv1.CStorVolumeReplica, error) {
+	list, err := b.C.OpenebsCS.CstorV1().CStorVolumeReplicas(b.C.Ns).List(metav1.ListOptions{
+		LabelSelector: "openebs.io/persistent-volume=" + volName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CVRs for volume %s: %w", volName, err)
+	}
+	return list.Items, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replicate gives every supported OpenEBS engine (cStor,
+// ZFS-LocalPV, Hwameistor) a single CLI verb for replication management, so
+// operators running a mixed fleet don't need one command per CR type.
+package replicate
+
+import "fmt"
+
+// ReplicationState is the engine-agnostic replication status a Backend
+// maps its own CR phase/state values onto.
+type ReplicationState string
+
+const (
+	// StateHealthy means the replica is in sync and serving IO.
+	StateHealthy ReplicationState = "Healthy"
+	// StateDegraded means the replica is out of sync with its peers and
+	// not actively catching up.
+	StateDegraded ReplicationState = "Degraded"
+	// StateResyncing means a replica is actively catching up.
+	StateResyncing ReplicationState = "Resyncing"
+	// StateOffline means a replica is unreachable.
+	StateOffline ReplicationState = "Offline"
+	// StateUnknown is used when a backend cannot map its native state.
+	StateUnknown ReplicationState = "Unknown"
+)
+
+// Replica is one replica of a volume, as reported by a Backend.
+type Replica struct {
+	Name  string
+	Pool  string
+	State ReplicationState
+}
+
+// Backend is implemented once per OpenEBS engine that has its own replica
+// CRs. Registry dispatches List/Promote/Demote/Resync/Status calls to the
+// Backend matching a volume's engine.
+type Backend interface {
+	// Name identifies the backend, e.g. "cstor", "zfs-localpv", "hwameistor".
+	Name() string
+	// List returns every replica of volName.
+	List(volName string) ([]Replica, error)
+	// Promote makes replicaName the primary/active replica.
+	Promote(volName, replicaName string) error
+	// Demote makes replicaName a passive/secondary replica.
+	Demote(volName, replicaName string) error
+	// Resync triggers a full rebuild of replicaName from its healthy peers.
+	Resync(volName, replicaName string) error
+	// Status reports the common ReplicationState for replicaName.
+	Status(volName, replicaName string) (ReplicationState, error)
+}
+
+// Registry dispatches replication operations to the Backend registered for
+// a volume's engine.
+type Registry struct {
+	backends map[string]Backend
+}
+
+// NewRegistry returns a Registry with the given backends registered by
+// their Name().
+func NewRegistry(backends ...Backend) *Registry {
+	r := &Registry{backends: map[string]Backend{}}
+	for _, b := range backends {
+		r.backends[b.Name()] = b
+	}
+	return r
+}
+
+// Get returns the Backend registered for engine, or an error if none is
+// registered.
+func (r *Registry) Get(engine string) (Backend, error) {
+	b, ok := r.backends[engine]
+	if !ok {
+		return nil, fmt.Errorf("no replication backend registered for engine %q", engine)
+	}
+	return b, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicate
+
+import (
+	"testing"
+
+	hwameistorv1 "github.com/hwameistor/hwameistor/pkg/apis/hwameistor/v1alpha1"
+	cstorv1 "github.com/openebs/api/pkg/apis/cstor/v1"
+)
+
+func TestCstorStateByPhase(t *testing.T) {
+	cases := []struct {
+		phase cstorv1.CStorVolumeReplicaPhase
+		want  ReplicationState
+	}{
+		{cstorv1.CVRStatusOnline, StateHealthy},
+		{cstorv1.CVRStatusDegraded, StateDegraded},
+		{cstorv1.CVRStatusRebuilding, StateResyncing},
+		{cstorv1.CVRStatusReconstructingNewReplica, StateResyncing},
+		{cstorv1.CVRStatusNewReplicaDegraded, StateResyncing},
+		{cstorv1.CVRStatusOffline, StateOffline},
+	}
+	for _, c := range cases {
+		if got := cstorStateByPhase[c.phase]; got != c.want {
+			t.Errorf("cstorStateByPhase[%s] = %s, want %s", c.phase, got, c.want)
+		}
+	}
+	if got, ok := cstorStateByPhase[cstorv1.CVRStatusInit]; ok {
+		t.Errorf("expected CVRStatusInit to be unmapped, got %s", got)
+	}
+}
+
+func TestHwameistorStateByState(t *testing.T) {
+	cases := []struct {
+		state hwameistorv1.State
+		want  ReplicationState
+	}{
+		{hwameistorv1.VolumeReplicaStateReady, StateHealthy},
+		{hwameistorv1.VolumeReplicaStateNotReady, StateDegraded},
+		{hwameistorv1.VolumeReplicaStateRebuilding, StateResyncing},
+	}
+	for _, c := range cases {
+		if got := hwameistorStateByState[c.state]; got != c.want {
+			t.Errorf("hwameistorStateByState[%v] = %s, want %s", c.state, got, c.want)
+		}
+	}
+}
+
+func TestRegistryGetUnknownEngine(t *testing.T) {
+	r := NewRegistry(&CstorBackend{}, &ZFSLocalPVBackend{})
+	if _, err := r.Get("made-up-engine"); err == nil {
+		t.Fatal("expected an error for an unregistered engine")
+	}
+}
+
+func TestRegistryGetReturnsRegisteredBackend(t *testing.T) {
+	cstor := &CstorBackend{}
+	r := NewRegistry(cstor, &ZFSLocalPVBackend{})
+	got, err := r.Get("cstor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != cstor {
+		t.Fatal("expected Get to return the registered CstorBackend")
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replicate
+
+import "fmt"
+
+// ZFSLocalPVBackend is the Backend implementation for ZFS-LocalPV. Unlike
+// cStor, ZFS-LocalPV is a local, single-replica engine today, so this
+// backend intentionally exposes every operation as unsupported rather than
+// faking a multi-replica topology it doesn't have.
+type ZFSLocalPVBackend struct{}
+
+// Name implements Backend.
+func (b *ZFSLocalPVBackend) Name() string { return "zfs-localpv" }
+
+// List implements Backend.
+func (b *ZFSLocalPVBackend) List(volName string) ([]Replica, error) {
+	return nil, fmt.Errorf("zfs-localpv volumes have a single local replica; replication management is not applicable")
+}
+
+// Promote implements Backend.
+func (b *ZFSLocalPVBackend) Promote(volName, replicaName string) error {
+	return fmt.Errorf("zfs-localpv does not support replica promotion")
+}
+
+// Demote implements Backend.
+func (b *ZFSLocalPVBackend) Demote(volName, replicaName string) error {
+	return fmt.Errorf("zfs-localpv does not support replica demotion")
+}
+
+// Resync implements Backend.
+func (b *ZFSLocalPVBackend) Resync(volName, replicaName string) error {
+	return fmt.Errorf("zfs-localpv does not support replica resync")
+}
+
+// Status implements Backend.
+func (b *ZFSLocalPVBackend) Status(volName, replicaName string) (ReplicationState, error) {
+	return StateUnknown, fmt.Errorf("zfs-localpv does not expose per-replica replication state")
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorpoolclusters
+
+// CStorPoolCluster describes the desired state of the set of cstor pools
+// that make up a cStor pool cluster
+type CStorPoolCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CStorPoolClusterSpec `json:"spec"`
+}
+
+// CStorPoolClusterSpec is the spec for a CStorPoolCluster resource
+type CStorPoolClusterSpec struct {
+	// Pools is the list of pool instances this CSPC manages, one per node.
+	Pools []CStorPoolClusterPool `json:"pools"`
+}
+
+// CStorPoolClusterPool describes a single pool instance within a CSPC.
+type CStorPoolClusterPool struct {
+	// NodeSelector picks the node this pool instance runs on.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorpoolclusters
+
+// CStorPoolClusterList is a list of CStorPoolCluster resources
+type CStorPoolClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CStorPoolCluster `json:"items"`
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorpoolinstances
+
+// CStorPoolInstance describes a cstor pool instance resource created as a
+// custom resource
+type CStorPoolInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CStorPoolInstanceSpec   `json:"spec"`
+	Status            CStorPoolInstanceStatus `json:"status"`
+}
+
+// CStorPoolInstanceSpec is the spec for a CStorPoolInstance resource
+type CStorPoolInstanceSpec struct {
+	// HostName is the name of kubernetes node where the pool should be created.
+	HostName string `json:"hostName"`
+	// NodeSelector is the labels that will be used to select a node for this
+	// pool's pod.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// CStorPoolInstancePhase is a typed string for phase field of CSPI.
+type CStorPoolInstancePhase string
+
+const (
+	// CStorPoolStatusOnline signifies that the pool is ready to be used.
+	CStorPoolStatusOnline CStorPoolInstancePhase = "ONLINE"
+	// CStorPoolStatusOffline signifies that the pool is not usable.
+	CStorPoolStatusOffline CStorPoolInstancePhase = "OFFLINE"
+	// CStorPoolStatusError signifies that the pool status couldn't be determined.
+	CStorPoolStatusError CStorPoolInstancePhase = "ERROR"
+)
+
+// CStorPoolInstanceStatus is for handling status of pool.
+type CStorPoolInstanceStatus struct {
+	// Phase describes the current health state of the pool.
+	Phase CStorPoolInstancePhase `json:"phase"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorpoolinstances
+
+// CStorPoolInstanceList is a list of CStorPoolInstance resources
+type CStorPoolInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CStorPoolInstance `json:"items"`
+}
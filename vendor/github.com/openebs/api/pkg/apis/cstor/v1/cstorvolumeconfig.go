@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The OpenEBS Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorvolumeconfigs
+
+// CStorVolumeConfig describes the desired state of a cStor volume, and is
+// what the cvc-operator reconciles against to create/scale the underlying
+// CStorVolumeReplicas.
+type CStorVolumeConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CStorVolumeConfigSpec `json:"spec"`
+}
+
+// CStorVolumeConfigSpec is the spec for a CStorVolumeConfig resource
+type CStorVolumeConfigSpec struct {
+	// Capacity is the desired size of the volume.
+	Capacity string `json:"capacity"`
+	// Policy holds the provisioning and scaling policy for the volume.
+	Policy CStorVolumePolicySpec `json:"policy,omitempty"`
+}
+
+// CStorVolumePolicySpec holds policy knobs for a CStorVolumeConfig.
+type CStorVolumePolicySpec struct {
+	// ReplicaPoolInfo is the list of CSPI pools the volume's replicas
+	// should live on. The cvc-operator's scaleVolumeReplicas reconciler
+	// diffs this list against the CVRs that actually exist and creates or
+	// removes replicas to match it.
+	ReplicaPoolInfo []ReplicaPoolInfo `json:"replicaPoolInfo,omitempty"`
+}
+
+// ReplicaPoolInfo names a single pool that a volume replica should be
+// placed on.
+type ReplicaPoolInfo struct {
+	// PoolName is the name of the CStorPoolInstance.
+	PoolName string `json:"poolName"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=cstorvolumeconfigs
+
+// CStorVolumeConfigList is a list of CStorVolumeConfig resources
+type CStorVolumeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CStorVolumeConfig `json:"items"`
+}